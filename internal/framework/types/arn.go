@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable  = (*arnType)(nil)
+	_ basetypes.StringValuable = (*ARN)(nil)
+)
+
+// arnType is the attribute type of an ARN value. Fields declared as `types.ARN` in a struct
+// consumed by ListNestedObjectValueOf[T] (tagged `tfsdk:"arn" fwtype:"arn"`) get this type instead
+// of the plain basetypes.StringType, which means they validate as well-formed ARNs and compare for
+// plan-diff purposes using arn.ARN equality rather than raw string equality.
+type arnType struct {
+	basetypes.StringType
+}
+
+// ARNType is the attr.Type for an ARN-valued attribute.
+var ARNType = arnType{}
+
+func (t arnType) Equal(o attr.Type) bool {
+	other, ok := o.(arnType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t arnType) String() string {
+	return "ARNType"
+}
+
+func (t arnType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() || in.IsUnknown() {
+		return ARN{StringValue: in}, diags
+	}
+
+	if _, err := arn.Parse(in.ValueString()); err != nil {
+		diags.AddError("Invalid ARN", fmt.Sprintf("%q: %s", in.ValueString(), err))
+		return nil, diags
+	}
+
+	return ARN{StringValue: in}, diags
+}
+
+func (t arnType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t arnType) ValueType(ctx context.Context) attr.Value {
+	return ARN{}
+}
+
+// ARN represents a Terraform Plugin Framework String value that holds a well-formed AWS ARN.
+type ARN struct {
+	basetypes.StringValue
+}
+
+func (v ARN) Equal(o attr.Value) bool {
+	other, ok := o.(ARN)
+
+	if !ok {
+		return false
+	}
+
+	if v.IsNull() || v.IsUnknown() || other.IsNull() || other.IsUnknown() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	vARN, diags := v.ValueARN()
+	if diags.HasError() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	oARN, diags := other.ValueARN()
+	if diags.HasError() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	return vARN == oARN
+}
+
+func (v ARN) Type(ctx context.Context) attr.Type {
+	return ARNType
+}
+
+// ValueARN returns the parsed arn.ARN represented by the value, or the zero value and an error
+// diagnostic if the value isn't a syntactically valid ARN.
+func (v ARN) ValueARN() (arn.ARN, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	a, err := arn.Parse(v.ValueString())
+	if err != nil {
+		diags.AddError("Invalid ARN", fmt.Sprintf("%q: %s", v.ValueString(), err))
+		return arn.ARN{}, diags
+	}
+
+	return a, diags
+}
+
+// ARNValue returns an ARN holding s. It does not validate that s is a well-formed ARN; use
+// ValueARN to do so.
+func ARNValue(s string) ARN {
+	return ARN{StringValue: basetypes.NewStringValue(s)}
+}
+
+func ARNNull() ARN {
+	return ARN{StringValue: basetypes.NewStringNull()}
+}
+
+func ARNUnknown() ARN {
+	return ARN{StringValue: basetypes.NewStringUnknown()}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestARNTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		in      basetypes.StringValue
+		wantErr bool
+	}{
+		"valid ARN":     {in: basetypes.NewStringValue("arn:aws:iam::123456789012:role/example")},
+		"invalid ARN":   {in: basetypes.NewStringValue("not-an-arn"), wantErr: true},
+		"null value":    {in: basetypes.NewStringNull()},
+		"unknown value": {in: basetypes.NewStringUnknown()},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := ARNType.ValueFromString(ctx, testCase.in)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("HasError() = %t, want %t (diags: %s)", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestARNEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b ARN
+		want bool
+	}{
+		"equal ARNs": {
+			a:    ARNValue("arn:aws:iam::123456789012:role/example"),
+			b:    ARNValue("arn:aws:iam::123456789012:role/example"),
+			want: true,
+		},
+		"different ARNs": {
+			a:    ARNValue("arn:aws:iam::123456789012:role/example"),
+			b:    ARNValue("arn:aws:iam::123456789012:role/other"),
+			want: false,
+		},
+		"null and unknown": {
+			a:    ARNNull(),
+			b:    ARNUnknown(),
+			want: false,
+		},
+		"both null": {
+			a:    ARNNull(),
+			b:    ARNNull(),
+			want: true,
+		},
+		"invalid ARN falls back to string equality": {
+			a:    ARNValue("not-an-arn"),
+			b:    ARNValue("not-an-arn"),
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.a.Equal(testCase.b); got != testCase.want {
+				t.Errorf("Equal() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
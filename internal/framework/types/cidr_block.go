@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable  = (*cidrBlockType)(nil)
+	_ basetypes.StringValuable = (*CIDRBlock)(nil)
+)
+
+// cidrBlockType is the attribute type of a CIDRBlock value. Fields declared as `types.CIDRBlock`
+// in a struct consumed by ListNestedObjectValueOf[T] (tagged `tfsdk:"cidr_block"
+// fwtype:"cidr_block"`) get this type instead of the plain basetypes.StringType, which means they
+// validate as well-formed CIDR blocks.
+type cidrBlockType struct {
+	basetypes.StringType
+}
+
+// CIDRBlockType is the attr.Type for a CIDR-block-valued attribute.
+var CIDRBlockType = cidrBlockType{}
+
+func (t cidrBlockType) Equal(o attr.Type) bool {
+	other, ok := o.(cidrBlockType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t cidrBlockType) String() string {
+	return "CIDRBlockType"
+}
+
+func (t cidrBlockType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() || in.IsUnknown() {
+		return CIDRBlock{StringValue: in}, diags
+	}
+
+	if _, err := netip.ParsePrefix(in.ValueString()); err != nil {
+		diags.AddError("Invalid CIDR block", fmt.Sprintf("%q: %s", in.ValueString(), err))
+		return nil, diags
+	}
+
+	return CIDRBlock{StringValue: in}, diags
+}
+
+func (t cidrBlockType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t cidrBlockType) ValueType(ctx context.Context) attr.Value {
+	return CIDRBlock{}
+}
+
+// CIDRBlock represents a Terraform Plugin Framework String value that holds a well-formed CIDR
+// block, e.g. "10.0.0.0/16" or "2001:db8::/32".
+type CIDRBlock struct {
+	basetypes.StringValue
+}
+
+func (v CIDRBlock) Equal(o attr.Value) bool {
+	other, ok := o.(CIDRBlock)
+
+	if !ok {
+		return false
+	}
+
+	if v.IsNull() || v.IsUnknown() || other.IsNull() || other.IsUnknown() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	vPrefix, diags := v.ValuePrefix()
+	if diags.HasError() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	oPrefix, diags := other.ValuePrefix()
+	if diags.HasError() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	return vPrefix == oPrefix
+}
+
+func (v CIDRBlock) Type(ctx context.Context) attr.Type {
+	return CIDRBlockType
+}
+
+// ValuePrefix returns the parsed netip.Prefix represented by the value, or the zero value and an
+// error diagnostic if the value isn't a syntactically valid CIDR block.
+func (v CIDRBlock) ValuePrefix() (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	p, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		diags.AddError("Invalid CIDR block", fmt.Sprintf("%q: %s", v.ValueString(), err))
+		return netip.Prefix{}, diags
+	}
+
+	return p, diags
+}
+
+// CIDRBlockValue returns a CIDRBlock holding s. It does not validate that s is a well-formed CIDR
+// block; use ValuePrefix to do so.
+func CIDRBlockValue(s string) CIDRBlock {
+	return CIDRBlock{StringValue: basetypes.NewStringValue(s)}
+}
+
+func CIDRBlockNull() CIDRBlock {
+	return CIDRBlock{StringValue: basetypes.NewStringNull()}
+}
+
+func CIDRBlockUnknown() CIDRBlock {
+	return CIDRBlock{StringValue: basetypes.NewStringUnknown()}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCIDRBlockTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		in      basetypes.StringValue
+		wantErr bool
+	}{
+		"valid IPv4 CIDR": {in: basetypes.NewStringValue("10.0.0.0/16")},
+		"valid IPv6 CIDR": {in: basetypes.NewStringValue("2001:db8::/32")},
+		"invalid CIDR":    {in: basetypes.NewStringValue("not-a-cidr"), wantErr: true},
+		"null value":      {in: basetypes.NewStringNull()},
+		"unknown value":   {in: basetypes.NewStringUnknown()},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := CIDRBlockType.ValueFromString(ctx, testCase.in)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("HasError() = %t, want %t (diags: %s)", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestCIDRBlockValuePrefix(t *testing.T) {
+	t.Parallel()
+
+	if _, diags := CIDRBlockValue("10.0.0.0/16").ValuePrefix(); diags.HasError() {
+		t.Errorf("unexpected error: %s", diags)
+	}
+
+	if _, diags := CIDRBlockValue("not-a-cidr").ValuePrefix(); !diags.HasError() {
+		t.Error("expected an error for an invalid CIDR block")
+	}
+}
+
+func TestCIDRBlockEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b CIDRBlock
+		want bool
+	}{
+		"equal CIDR blocks, same representation": {
+			a:    CIDRBlockValue("10.0.0.0/16"),
+			b:    CIDRBlockValue("10.0.0.0/16"),
+			want: true,
+		},
+		"equal CIDR blocks, different representation": {
+			a:    CIDRBlockValue("2001:0db8::/32"),
+			b:    CIDRBlockValue("2001:db8::/32"),
+			want: true,
+		},
+		"different CIDR blocks": {
+			a:    CIDRBlockValue("10.0.0.0/16"),
+			b:    CIDRBlockValue("10.1.0.0/16"),
+			want: false,
+		},
+		"null and unknown": {
+			a:    CIDRBlockNull(),
+			b:    CIDRBlockUnknown(),
+			want: false,
+		},
+		"both null": {
+			a:    CIDRBlockNull(),
+			b:    CIDRBlockNull(),
+			want: true,
+		},
+		"invalid CIDR block falls back to string equality": {
+			a:    CIDRBlockValue("not-a-cidr"),
+			b:    CIDRBlockValue("not-a-cidr"),
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.a.Equal(testCase.b); got != testCase.want {
+				t.Errorf("Equal() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable  = (*durationType)(nil)
+	_ basetypes.StringValuable = (*Duration)(nil)
+)
+
+// durationType is the attribute type of a Duration value. Fields declared as `types.Duration` in a
+// struct consumed by ListNestedObjectValueOf[T] (tagged `tfsdk:"duration" fwtype:"duration"`) get
+// this type instead of the plain basetypes.StringType, which means they validate as well-formed Go
+// durations (e.g. "30s", "5m") and can be converted directly to a time.Duration.
+type durationType struct {
+	basetypes.StringType
+}
+
+// DurationType is the attr.Type for a duration-valued attribute.
+var DurationType = durationType{}
+
+func (t durationType) Equal(o attr.Type) bool {
+	other, ok := o.(durationType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t durationType) String() string {
+	return "DurationType"
+}
+
+func (t durationType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() || in.IsUnknown() {
+		return Duration{StringValue: in}, diags
+	}
+
+	if _, err := time.ParseDuration(in.ValueString()); err != nil {
+		diags.AddError("Invalid duration", fmt.Sprintf("%q: %s", in.ValueString(), err))
+		return nil, diags
+	}
+
+	return Duration{StringValue: in}, diags
+}
+
+func (t durationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t durationType) ValueType(ctx context.Context) attr.Value {
+	return Duration{}
+}
+
+// Duration represents a Terraform Plugin Framework String value that holds a Go duration string,
+// e.g. "30s" or "5m".
+type Duration struct {
+	basetypes.StringValue
+}
+
+func (v Duration) Equal(o attr.Value) bool {
+	other, ok := o.(Duration)
+
+	if !ok {
+		return false
+	}
+
+	if v.IsNull() || v.IsUnknown() || other.IsNull() || other.IsUnknown() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	vDuration, diags := v.ValueDuration()
+	if diags.HasError() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	oDuration, diags := other.ValueDuration()
+	if diags.HasError() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	return vDuration == oDuration
+}
+
+func (v Duration) Type(ctx context.Context) attr.Type {
+	return DurationType
+}
+
+// ValueDuration returns the parsed time.Duration represented by the value, or zero and an error
+// diagnostic if the value isn't a syntactically valid duration.
+func (v Duration) ValueDuration() (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		diags.AddError("Invalid duration", fmt.Sprintf("%q: %s", v.ValueString(), err))
+		return 0, diags
+	}
+
+	return d, diags
+}
+
+// DurationValue returns a Duration holding s. It does not validate that s is a well-formed
+// duration string; use ValueDuration to do so.
+func DurationValue(s string) Duration {
+	return Duration{StringValue: basetypes.NewStringValue(s)}
+}
+
+func DurationNull() Duration {
+	return Duration{StringValue: basetypes.NewStringNull()}
+}
+
+func DurationUnknown() Duration {
+	return Duration{StringValue: basetypes.NewStringUnknown()}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestDurationTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		in      basetypes.StringValue
+		wantErr bool
+	}{
+		"valid duration":   {in: basetypes.NewStringValue("30s")},
+		"invalid duration": {in: basetypes.NewStringValue("not-a-duration"), wantErr: true},
+		"null value":       {in: basetypes.NewStringNull()},
+		"unknown value":    {in: basetypes.NewStringUnknown()},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := DurationType.ValueFromString(ctx, testCase.in)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("HasError() = %t, want %t (diags: %s)", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestDurationValueDuration(t *testing.T) {
+	t.Parallel()
+
+	if _, diags := DurationValue("5m").ValueDuration(); diags.HasError() {
+		t.Errorf("unexpected error: %s", diags)
+	}
+
+	if _, diags := DurationValue("not-a-duration").ValueDuration(); !diags.HasError() {
+		t.Error("expected an error for an invalid duration")
+	}
+}
+
+func TestDurationEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b Duration
+		want bool
+	}{
+		"equal durations, same representation": {
+			a:    DurationValue("1h"),
+			b:    DurationValue("1h"),
+			want: true,
+		},
+		"equal durations, different representation": {
+			a:    DurationValue("3600s"),
+			b:    DurationValue("1h"),
+			want: true,
+		},
+		"different durations": {
+			a:    DurationValue("30s"),
+			b:    DurationValue("1m"),
+			want: false,
+		},
+		"null and unknown": {
+			a:    DurationNull(),
+			b:    DurationUnknown(),
+			want: false,
+		},
+		"both null": {
+			a:    DurationNull(),
+			b:    DurationNull(),
+			want: true,
+		},
+		"invalid duration falls back to string equality": {
+			a:    DurationValue("not-a-duration"),
+			b:    DurationValue("not-a-duration"),
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.a.Equal(testCase.b); got != testCase.want {
+				t.Errorf("Equal() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// elementSemanticEqualityFunc compares a single pair of paired elements for semantic equality,
+// given the index they were paired at. Diagnostics it returns are annotated with that index by the
+// adapter returned from adaptElementSemanticEqualityFunc, via WithElementSemanticEqualityFunc.
+type elementSemanticEqualityFunc[T any] func(ctx context.Context, i int, old, new *T) (bool, diag.Diagnostics)
+
+// adaptElementSemanticEqualityFunc lifts an elementSemanticEqualityFunc[T] into the
+// semanticEqualityFunc[T] slot that ListNestedObjectTypeOf[T] and ListNestedObjectValueOf[T]
+// actually invoke. Elements are paired positionally, unless keyFn is non-nil, in which case they
+// are paired using the same key-based matching as NewUnorderedSemanticEqualityFunc. root, supplied
+// by WithElementSemanticEqualityFunc's attributeName argument, is prepended to each paired
+// element's index so diagnostics point at e.g. path.Root("rule").AtListIndex(2) instead of a bare
+// index with no indication of which list attribute it came from.
+func adaptElementSemanticEqualityFunc[T any](f elementSemanticEqualityFunc[T], keyFn func(*T) string, root path.Path) semanticEqualityFunc[T] {
+	return func(ctx context.Context, oldValue, newValue NestedCollectionValue[T]) (bool, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		oldSlice, d := oldValue.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		newSlice, d := newValue.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if len(oldSlice) != len(newSlice) {
+			return false, diags
+		}
+
+		if keyFn != nil {
+			return elementSemanticEqualsByKey(ctx, f, keyFn, root, oldSlice, newSlice)
+		}
+
+		return elementSemanticEqualsByPosition(ctx, f, root, oldSlice, newSlice)
+	}
+}
+
+func elementSemanticEqualsByPosition[T any](ctx context.Context, f elementSemanticEqualityFunc[T], root path.Path, oldSlice, newSlice []*T) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	equal := true
+	for i, oldElem := range oldSlice {
+		elemEqual, d := f(ctx, i, oldElem, newSlice[i])
+		diags.Append(annotate(root, i, d)...)
+
+		if !elemEqual {
+			equal = false
+		}
+	}
+
+	return equal, diags
+}
+
+// elementSemanticEqualsByKey pairs each element of oldSlice with the element of newSlice sharing
+// its key, the same multiset matching NewUnorderedSemanticEqualityFunc uses: a key shared by
+// multiple elements (e.g. two statements with the same sid) is paired one-to-one against distinct
+// new elements rather than every old element under that key comparing against the same
+// first-seen candidate.
+func elementSemanticEqualsByKey[T any](ctx context.Context, f elementSemanticEqualityFunc[T], keyFn func(*T) string, root path.Path, oldSlice, newSlice []*T) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newByKey := make(map[string][]*T, len(newSlice))
+	for _, v := range newSlice {
+		k := keyFn(v)
+		newByKey[k] = append(newByKey[k], v)
+	}
+
+	equal := true
+	for i, oldElem := range oldSlice {
+		candidates := newByKey[keyFn(oldElem)]
+
+		// matched is the index, within candidates, of the unused candidate f reports equal to
+		// oldElem, or -1 if none matches. reported is the candidate whose comparison diagnostics
+		// are surfaced: the match if one was found, otherwise the first remaining candidate (if
+		// any), so a genuinely differing pair still gets a useful diagnostic rather than none.
+		matched := -1
+		reported := -1
+		var reportedDiags diag.Diagnostics
+
+		for j, newElem := range candidates {
+			if newElem == nil {
+				continue
+			}
+
+			elemEqual, d := f(ctx, i, oldElem, newElem)
+			if elemEqual {
+				matched = j
+				reported = j
+				reportedDiags = d
+				break
+			}
+
+			if reported == -1 {
+				reported = j
+				reportedDiags = d
+			}
+		}
+
+		if reported != -1 {
+			diags.Append(annotate(root, i, reportedDiags)...)
+		}
+
+		if matched == -1 {
+			equal = false
+			continue
+		}
+
+		candidates[matched] = nil
+	}
+
+	return equal, diags
+}
+
+// annotate wraps each diagnostic in d with a path pointing at root's i'th list element.
+func annotate(root path.Path, i int, d diag.Diagnostics) diag.Diagnostics {
+	out := make(diag.Diagnostics, 0, len(d))
+	for _, dg := range d {
+		out = append(out, diag.WithPath(root.AtListIndex(i), dg))
+	}
+
+	return out
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type elementSemanticEqualityTestElement struct {
+	ID    basetypes.StringValue `tfsdk:"id"`
+	Value basetypes.StringValue `tfsdk:"value"`
+}
+
+func elementSemanticEqualityTestCompare(_ context.Context, _ int, old, new *elementSemanticEqualityTestElement) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	equal := old.Value.Equal(new.Value)
+	if !equal {
+		diags.AddWarning("value changed", "value differs between plan and state")
+	}
+
+	return equal, diags
+}
+
+func TestWithElementSemanticEqualityFuncPositional(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	opt := WithElementSemanticEqualityFunc("items", elementSemanticEqualityTestCompare)
+
+	oldValue, diags := NewListNestedObjectValueOfValueSlice(ctx, []elementSemanticEqualityTestElement{
+		{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+		{ID: basetypes.NewStringValue("b"), Value: basetypes.NewStringValue("2")},
+	}, opt)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	newValue, diags := NewListNestedObjectValueOfValueSlice(ctx, []elementSemanticEqualityTestElement{
+		{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+		{ID: basetypes.NewStringValue("b"), Value: basetypes.NewStringValue("CHANGED")},
+	}, opt)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	equal, diags := oldValue.ListSemanticEquals(ctx, newValue)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+	if equal {
+		t.Error("got equal = true, want false")
+	}
+
+	wantPath := path.Root("items").AtListIndex(1)
+	found := false
+	for _, d := range diags {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok {
+			continue
+		}
+		if withPath.Path().Equal(wantPath) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("no diagnostic annotated with path %s; got %s", wantPath, diags)
+	}
+}
+
+func TestWithElementSemanticEqualityFuncByKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyFn := func(e *elementSemanticEqualityTestElement) string { return e.ID.ValueString() }
+
+	oldValue, diags := NewListNestedObjectValueOfValueSlice(ctx, []elementSemanticEqualityTestElement{
+		{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+		{ID: basetypes.NewStringValue("b"), Value: basetypes.NewStringValue("2")},
+	}, WithElementSemanticEqualityFunc("items", elementSemanticEqualityTestCompare), WithKeyFunc(keyFn))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	// Reordered, unchanged values should still compare equal when paired by key.
+	newValue, diags := NewListNestedObjectValueOfValueSlice(ctx, []elementSemanticEqualityTestElement{
+		{ID: basetypes.NewStringValue("b"), Value: basetypes.NewStringValue("2")},
+		{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+	}, WithElementSemanticEqualityFunc("items", elementSemanticEqualityTestCompare), WithKeyFunc(keyFn))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	equal, diags := oldValue.ListSemanticEquals(ctx, newValue)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+	if !equal {
+		t.Error("got equal = false, want true")
+	}
+}
+
+func TestWithElementSemanticEqualityFuncByKeyDuplicateKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyFn := func(e *elementSemanticEqualityTestElement) string { return e.ID.ValueString() }
+
+	// Two elements share the key "k". Reordered and otherwise unchanged, this is the same
+	// multiset, so it must still compare equal -- a plain map[string]*T keyed lookup would let the
+	// second "k" silently overwrite the first, comparing one old "k" element against both new "k"
+	// elements and never pairing the other, producing a false "not equal".
+	oldValue, diags := NewListNestedObjectValueOfValueSlice(ctx, []elementSemanticEqualityTestElement{
+		{ID: basetypes.NewStringValue("k"), Value: basetypes.NewStringValue("1")},
+		{ID: basetypes.NewStringValue("k"), Value: basetypes.NewStringValue("2")},
+	}, WithElementSemanticEqualityFunc("items", elementSemanticEqualityTestCompare), WithKeyFunc(keyFn))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	newValue, diags := NewListNestedObjectValueOfValueSlice(ctx, []elementSemanticEqualityTestElement{
+		{ID: basetypes.NewStringValue("k"), Value: basetypes.NewStringValue("2")},
+		{ID: basetypes.NewStringValue("k"), Value: basetypes.NewStringValue("1")},
+	}, WithElementSemanticEqualityFunc("items", elementSemanticEqualityTestCompare), WithKeyFunc(keyFn))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	equal, diags := oldValue.ListSemanticEquals(ctx, newValue)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+	if !equal {
+		t.Errorf("got equal = false, want true (diags: %s)", diags)
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework/attr"
+
+// fwtypeStructTag is the struct tag newObjectTypeOf consults, in addition to `tfsdk`, to decide
+// whether a field should get a semantically-typed attr.Type (ARN, CIDRBlock, Duration, Regexp)
+// instead of the plain basetypes type its Go kind would otherwise imply. For example:
+//
+//	type Rule struct {
+//		RoleARN types.ARN `tfsdk:"role_arn" fwtype:"arn"`
+//	}
+const fwtypeStructTag = "fwtype"
+
+// fwtypeElementTypesByTag maps a `fwtype` struct tag value to the attr.Type newObjectTypeOf should
+// use for that field, overriding the type it would otherwise infer from the field's Go type.
+var fwtypeElementTypesByTag = map[string]attr.Type{
+	"arn":        ARNType,
+	"cidr_block": CIDRBlockType,
+	"duration":   DurationType,
+	"regexp":     RegexpType,
+}
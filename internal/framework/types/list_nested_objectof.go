@@ -15,75 +15,162 @@ import (
 )
 
 var (
-	_ basetypes.ListTypable                    = (*listNestedObjectTypeOf[struct{}])(nil)
-	_ NestedObjectCollectionType               = (*listNestedObjectTypeOf[struct{}])(nil)
+	_ basetypes.ListTypable                    = (*ListNestedObjectTypeOf[struct{}])(nil)
+	_ NestedObjectCollectionType               = (*ListNestedObjectTypeOf[struct{}])(nil)
+	_ ListNestedObjectTypable[struct{}]        = (*ListNestedObjectTypeOf[struct{}])(nil)
 	_ basetypes.ListValuable                   = (*ListNestedObjectValueOf[struct{}])(nil)
 	_ NestedObjectCollectionValue              = (*ListNestedObjectValueOf[struct{}])(nil)
 	_ basetypes.ListValuableWithSemanticEquals = (*ListNestedObjectValueOf[struct{}])(nil)
+	_ ListNestedObjectValuable[struct{}]       = (*ListNestedObjectValueOf[struct{}])(nil)
 )
 
 type semanticEqualityFunc[T any] func(context.Context, NestedCollectionValue[T], NestedCollectionValue[T]) (bool, diag.Diagnostics)
 
-// listNestedObjectTypeOf is the attribute type of a ListNestedObjectValueOf.
-type listNestedObjectTypeOf[T any] struct {
+// ListNestedObjectTypable extends basetypes.ListTypable for attribute types whose elements are
+// Terraform Objects backed by a struct of type T. Provider-defined types embed
+// ListNestedObjectTypeOf[T] and implement this interface to layer their own validation,
+// defaulting, or normalization on top of the built-in list-of-objects behavior (e.g. a
+// RouteTableRulesType that validates CIDRs at the collection level, or a TagSetType that enforces
+// the AWS tag-count limit), mirroring how basetypes supports embedding ObjectTypable.
+type ListNestedObjectTypable[T any] interface {
+	basetypes.ListTypable
+	NestedObjectCollectionType
+
+	// ValueFromListNestedObject returns the ListNestedObjectValuable of the implementing type,
+	// given the base ListNestedObjectValueOf[T] it wraps. A type embedding
+	// ListNestedObjectTypeOf[T] overrides this method to construct itself instead of returning v
+	// unchanged.
+	//
+	// Because Go embedding promotes methods rather than dispatching them virtually,
+	// ValueFromList/ValueFromTerraform/ValueType as promoted from ListNestedObjectTypeOf[T] would
+	// always call this method on the embedded ListNestedObjectTypeOf[T] itself, never on the
+	// embedding type. So that ValueType, ValueFromList, and ValueFromTerraform round-trip as the
+	// embedding type rather than as ListNestedObjectTypeOf[T], a type embedding
+	// ListNestedObjectTypeOf[T] must also override those three methods, each a thin wrapper
+	// delegating to the correspondingly-named ListNestedObjectTypableValueFrom*/ValueType
+	// package-level helper and passing itself (not the embedded ListNestedObjectTypeOf[T]) as typ.
+	ValueFromListNestedObject(ctx context.Context, v ListNestedObjectValueOf[T]) (ListNestedObjectValuable[T], diag.Diagnostics)
+}
+
+// ListNestedObjectValuable extends basetypes.ListValuable for attribute values whose elements are
+// Terraform Objects backed by a struct of type T. Provider-defined types embed
+// ListNestedObjectValueOf[T] and implement this interface to round-trip as themselves through
+// Type rather than as the base ListNestedObjectValueOf[T]. ListSemanticEquals, as promoted from
+// ListNestedObjectValueOf[T], accepts only a bare ListNestedObjectValueOf[T] as the new value and
+// returns an error diagnostic for any other type, including the embedding type itself.
+//
+// Type, as promoted from ListNestedObjectValueOf[T], always returns the bare
+// ListNestedObjectTypeOf[T] (the same promotion-not-dispatch limitation documented on
+// ListNestedObjectTypable[T].ValueFromListNestedObject), so a type embedding
+// ListNestedObjectValueOf[T] must also override Type to return its own Typable.
+type ListNestedObjectValuable[T any] interface {
+	basetypes.ListValuable
+	NestedObjectCollectionValue
+
+	// ToListNestedObject returns the base ListNestedObjectValueOf[T] that the implementing value
+	// wraps.
+	ToListNestedObject(ctx context.Context) (ListNestedObjectValueOf[T], diag.Diagnostics)
+}
+
+// ListNestedObjectTypeOf is the attribute type of a ListNestedObjectValueOf. It is exported so
+// that provider-defined types implementing ListNestedObjectTypable[T] (e.g. a RouteTableRulesType)
+// can embed it directly rather than reimplementing basetypes.ListTypable and
+// NestedObjectCollectionType from scratch.
+type ListNestedObjectTypeOf[T any] struct {
 	basetypes.ListType
-	semanticEqualityFunc semanticEqualityFunc[T]
+	semanticEqualityFunc  semanticEqualityFunc[T]
+	omitEmpty             bool
+	omitEmptyElementsFunc func(*T) bool
 }
 
-func NewListNestedObjectTypeOf[T any](ctx context.Context, f ...NestedObjectOfOption[T]) listNestedObjectTypeOf[T] {
+func NewListNestedObjectTypeOf[T any](ctx context.Context, f ...NestedObjectOfOption[T]) ListNestedObjectTypeOf[T] {
 	opts := newNestedObjectOfOptions(f...)
 
-	return listNestedObjectTypeOf[T]{
-		ListType:             basetypes.ListType{ElemType: NewObjectTypeOf[T](ctx)},
-		semanticEqualityFunc: opts.SemanticEqualityFunc,
+	return ListNestedObjectTypeOf[T]{
+		ListType:              basetypes.ListType{ElemType: NewObjectTypeOf[T](ctx)},
+		semanticEqualityFunc:  opts.SemanticEqualityFunc,
+		omitEmpty:             opts.OmitEmpty,
+		omitEmptyElementsFunc: opts.OmitEmptyElementsFunc,
 	}
 }
 
-func (t listNestedObjectTypeOf[T]) Equal(o attr.Type) bool {
-	other, ok := o.(listNestedObjectTypeOf[T])
+func (t ListNestedObjectTypeOf[T]) Equal(o attr.Type) bool {
+	other, ok := o.(ListNestedObjectTypable[T])
 
 	if !ok {
 		return false
 	}
 
-	return t.ListType.Equal(other.ListType)
+	return t.ElementType().Equal(other.ElementType())
+}
+
+// SemanticEqualityFunc returns the semantic-equality comparator configured for t, so that a type
+// embedding ListNestedObjectTypeOf[T] can retrieve it (its own semanticEqualityFunc field is
+// unexported) and pass it through to the ListNestedObjectTypableValueFromList/ValueType helpers
+// when overriding ValueFromList and ValueType.
+func (t ListNestedObjectTypeOf[T]) SemanticEqualityFunc() semanticEqualityFunc[T] {
+	return t.semanticEqualityFunc
 }
 
-func (t listNestedObjectTypeOf[T]) String() string {
+func (t ListNestedObjectTypeOf[T]) String() string {
 	var zero T
 	return fmt.Sprintf("ListNestedObjectTypeOf[%T]", zero)
 }
 
-func (t listNestedObjectTypeOf[T]) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+func (t ListNestedObjectTypeOf[T]) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return ListNestedObjectTypableValueFromList[T](ctx, t, t.semanticEqualityFunc, in)
+}
+
+// ListNestedObjectTypableValueFromList implements ValueFromList on behalf of a
+// ListNestedObjectTypable[T], routing the constructed base value through
+// typ.ValueFromListNestedObject so that a type embedding ListNestedObjectTypeOf[T] gets back its
+// own wrapping value rather than the bare ListNestedObjectValueOf[T]. Embedding types that
+// override ValueFromList should call this helper, passing themselves as typ.
+func ListNestedObjectTypableValueFromList[T any](ctx context.Context, typ ListNestedObjectTypable[T], semanticEquality semanticEqualityFunc[T], in basetypes.ListValue) (ListNestedObjectValuable[T], diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if in.IsNull() {
-		return NewListNestedObjectValueOfNull[T](ctx), diags
+		return typ.ValueFromListNestedObject(ctx, NewListNestedObjectValueOfNull[T](ctx))
 	}
 	if in.IsUnknown() {
-		return NewListNestedObjectValueOfUnknown[T](ctx), diags
+		return typ.ValueFromListNestedObject(ctx, NewListNestedObjectValueOfUnknown[T](ctx))
 	}
 
-	typ, d := newObjectTypeOf[T](ctx)
+	elemType, d := newObjectTypeOf[T](ctx)
 	diags.Append(d...)
 	if diags.HasError() {
-		return NewListNestedObjectValueOfUnknown[T](ctx), diags
+		return typ.ValueFromListNestedObject(ctx, NewListNestedObjectValueOfUnknown[T](ctx))
 	}
 
-	v, d := basetypes.NewListValue(typ, in.Elements())
+	v, d := basetypes.NewListValue(elemType, in.Elements())
 	diags.Append(d...)
 	if diags.HasError() {
-		return NewListNestedObjectValueOfUnknown[T](ctx), diags
+		return typ.ValueFromListNestedObject(ctx, NewListNestedObjectValueOfUnknown[T](ctx))
 	}
 
-	return ListNestedObjectValueOf[T]{
+	base := ListNestedObjectValueOf[T]{
 		ListValue:            v,
-		semanticEqualityFunc: t.semanticEqualityFunc,
-	}, diags
+		semanticEqualityFunc: semanticEquality,
+	}
+	value, d := typ.ValueFromListNestedObject(ctx, base)
+	diags.Append(d...)
+
+	return value, diags
+}
+
+func (t ListNestedObjectTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return ListNestedObjectTypableValueFromTerraform[T](ctx, t, in)
 }
 
-func (t listNestedObjectTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+// ListNestedObjectTypableValueFromTerraform implements ValueFromTerraform on behalf of a
+// ListNestedObjectTypable[T], decoding in via the plain List(Object) wire format and then routing
+// the result through typ.ValueFromList, so that a type embedding ListNestedObjectTypeOf[T] gets
+// back its own wrapping value rather than the bare ListNestedObjectValueOf[T]. Embedding types
+// that override ValueFromTerraform should call this helper, passing themselves as typ.
+func ListNestedObjectTypableValueFromTerraform[T any](ctx context.Context, typ ListNestedObjectTypable[T], in tftypes.Value) (attr.Value, error) {
+	listType := basetypes.ListType{ElemType: NewObjectTypeOf[T](ctx)}
+
+	attrValue, err := listType.ValueFromTerraform(ctx, in)
 
 	if err != nil {
 		return nil, err
@@ -95,7 +182,7 @@ func (t listNestedObjectTypeOf[T]) ValueFromTerraform(ctx context.Context, in tf
 		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
 	}
 
-	listValuable, diags := t.ValueFromList(ctx, listValue)
+	listValuable, diags := typ.ValueFromList(ctx, listValue)
 
 	if diags.HasError() {
 		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
@@ -104,48 +191,78 @@ func (t listNestedObjectTypeOf[T]) ValueFromTerraform(ctx context.Context, in tf
 	return listValuable, nil
 }
 
-func (t listNestedObjectTypeOf[T]) ValueType(ctx context.Context) attr.Value {
-	return ListNestedObjectValueOf[T]{semanticEqualityFunc: t.semanticEqualityFunc}
+func (t ListNestedObjectTypeOf[T]) ValueType(ctx context.Context) attr.Value {
+	return ListNestedObjectTypableValueType[T](ctx, t, t.semanticEqualityFunc)
+}
+
+// ListNestedObjectTypableValueType implements ValueType on behalf of a ListNestedObjectTypable[T],
+// routing through typ.ValueFromListNestedObject so that a type embedding ListNestedObjectTypeOf[T]
+// reports its own wrapping value rather than the bare ListNestedObjectValueOf[T]. Embedding types
+// that override ValueType should call this helper, passing themselves as typ.
+func ListNestedObjectTypableValueType[T any](ctx context.Context, typ ListNestedObjectTypable[T], semanticEquality semanticEqualityFunc[T]) attr.Value {
+	v, _ := typ.ValueFromListNestedObject(ctx, ListNestedObjectValueOf[T]{semanticEqualityFunc: semanticEquality})
+	return v
+}
+
+// ValueFromListNestedObject returns v unchanged. A type embedding ListNestedObjectTypeOf[T]
+// overrides this method to wrap v in its own Valuable type instead.
+func (t ListNestedObjectTypeOf[T]) ValueFromListNestedObject(ctx context.Context, v ListNestedObjectValueOf[T]) (ListNestedObjectValuable[T], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return v, diags
 }
 
-func (t listNestedObjectTypeOf[T]) NewObjectPtr(ctx context.Context) (any, diag.Diagnostics) {
+func (t ListNestedObjectTypeOf[T]) NewObjectPtr(ctx context.Context) (any, diag.Diagnostics) {
 	return objectTypeNewObjectPtr[T](ctx)
 }
 
-func (t listNestedObjectTypeOf[T]) NewObjectSlice(ctx context.Context, len, cap int) (any, diag.Diagnostics) {
+func (t ListNestedObjectTypeOf[T]) NewObjectSlice(ctx context.Context, len, cap int) (any, diag.Diagnostics) {
 	return nestedObjectTypeNewObjectSlice[T](ctx, len, cap)
 }
 
-func (t listNestedObjectTypeOf[T]) NullValue(ctx context.Context) (attr.Value, diag.Diagnostics) {
+func (t ListNestedObjectTypeOf[T]) NullValue(ctx context.Context) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	return NewListNestedObjectValueOfNull[T](ctx, WithSemanticEqualityFunc(t.semanticEqualityFunc)), diags
 }
 
-func (t listNestedObjectTypeOf[T]) ValueFromObjectPtr(ctx context.Context, ptr any) (attr.Value, diag.Diagnostics) {
+func (t ListNestedObjectTypeOf[T]) ValueFromObjectPtr(ctx context.Context, ptr any) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	if v, ok := ptr.(*T); ok {
-		v, d := newListNestedObjectValueOfPtr(ctx, v, t.semanticEqualityFunc)
-		diags.Append(d...)
-		return v, d
+	v, ok := ptr.(*T)
+	if !ok {
+		diags.Append(diag.NewErrorDiagnostic("Invalid pointer value", fmt.Sprintf("incorrect type: want %T, got %T", (*T)(nil), ptr)))
+		return nil, diags
 	}
 
-	diags.Append(diag.NewErrorDiagnostic("Invalid pointer value", fmt.Sprintf("incorrect type: want %T, got %T", (*T)(nil), ptr)))
-	return nil, diags
+	if t.omitEmpty && (v == nil || (t.omitEmptyElementsFunc != nil && t.omitEmptyElementsFunc(v))) {
+		return NewListNestedObjectValueOfNull[T](ctx, WithSemanticEqualityFunc(t.semanticEqualityFunc)), diags
+	}
+
+	value, d := newListNestedObjectValueOfPtr(ctx, v, t.semanticEqualityFunc)
+	diags.Append(d...)
+	return value, d
 }
 
-func (t listNestedObjectTypeOf[T]) ValueFromObjectSlice(ctx context.Context, slice any) (attr.Value, diag.Diagnostics) {
+func (t ListNestedObjectTypeOf[T]) ValueFromObjectSlice(ctx context.Context, slice any) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	if v, ok := slice.([]*T); ok {
-		v, d := NewListNestedObjectValueOfSlice(ctx, v, t.semanticEqualityFunc)
-		diags.Append(d...)
-		return v, d
+	ts, ok := slice.([]*T)
+	if !ok {
+		diags.Append(diag.NewErrorDiagnostic("Invalid slice value", fmt.Sprintf("incorrect type: want %T, got %T", (*[]T)(nil), slice)))
+		return nil, diags
+	}
+
+	if t.omitEmptyElementsFunc != nil {
+		ts = filterOmittedPtrElements(ts, nestedObjectOfOptions[T]{OmitEmptyElementsFunc: t.omitEmptyElementsFunc})
+	}
+
+	if t.omitEmpty && len(ts) == 0 {
+		return NewListNestedObjectValueOfNull[T](ctx, WithSemanticEqualityFunc(t.semanticEqualityFunc)), diags
 	}
 
-	diags.Append(diag.NewErrorDiagnostic("Invalid slice value", fmt.Sprintf("incorrect type: want %T, got %T", (*[]T)(nil), slice)))
-	return nil, diags
+	v, d := NewListNestedObjectValueOfSlice(ctx, ts, t.semanticEqualityFunc)
+	diags.Append(d...)
+	return v, d
 }
 
 func nestedObjectTypeNewObjectSlice[T any](_ context.Context, len, cap int) ([]*T, diag.Diagnostics) { //nolint:unparam
@@ -191,7 +308,21 @@ func (v ListNestedObjectValueOf[T]) ListSemanticEquals(ctx context.Context, newV
 }
 
 func (v ListNestedObjectValueOf[T]) Type(ctx context.Context) attr.Type {
-	return NewListNestedObjectTypeOf[T](ctx)
+	return NewListNestedObjectTypeOf[T](ctx, WithSemanticEqualityFunc(v.semanticEqualityFunc))
+}
+
+// SemanticEqualityFunc returns the semantic-equality comparator configured for v, so that a type
+// embedding ListNestedObjectValueOf[T] can retrieve it (its own semanticEqualityFunc field is
+// unexported) when overriding Type to report its own wrapping Typable.
+func (v ListNestedObjectValueOf[T]) SemanticEqualityFunc() semanticEqualityFunc[T] {
+	return v.semanticEqualityFunc
+}
+
+// ToListNestedObject returns v unchanged. A type embedding ListNestedObjectValueOf[T] overrides
+// this method to unwrap itself back to the base value instead.
+func (v ListNestedObjectValueOf[T]) ToListNestedObject(ctx context.Context) (ListNestedObjectValueOf[T], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return v, diags
 }
 
 func (v ListNestedObjectValueOf[T]) ToObjectPtr(ctx context.Context) (any, diag.Diagnostics) {
@@ -261,7 +392,13 @@ func NewListNestedObjectValueOfUnknown[T any](ctx context.Context) ListNestedObj
 }
 
 func NewListNestedObjectValueOfPtr[T any](ctx context.Context, t *T, f ...NestedObjectOfOption[T]) (ListNestedObjectValueOf[T], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	opts := newNestedObjectOfOptions(f...)
+	if opts.OmitEmpty && (t == nil || (opts.OmitEmptyElementsFunc != nil && opts.OmitEmptyElementsFunc(t))) {
+		return NewListNestedObjectValueOfNull[T](ctx, WithSemanticEqualityFunc(opts.SemanticEqualityFunc)), diags
+	}
+
 	return newListNestedObjectValueOfPtr(ctx, t, opts.SemanticEqualityFunc)
 }
 
@@ -271,6 +408,10 @@ func newListNestedObjectValueOfPtr[T any](ctx context.Context, t *T, f semanticE
 
 func NewListNestedObjectValueOfPtrMust[T any](ctx context.Context, t *T, f ...NestedObjectOfOption[T]) ListNestedObjectValueOf[T] {
 	opts := newNestedObjectOfOptions(f...)
+	if opts.OmitEmpty && (t == nil || (opts.OmitEmptyElementsFunc != nil && opts.OmitEmptyElementsFunc(t))) {
+		return NewListNestedObjectValueOfNull[T](ctx, WithSemanticEqualityFunc(opts.SemanticEqualityFunc))
+	}
+
 	return fwdiag.Must(newListNestedObjectValueOfPtr(ctx, t, opts.SemanticEqualityFunc))
 }
 
@@ -280,11 +421,23 @@ func NewListNestedObjectValueOfSlice[T any](ctx context.Context, ts []*T, f sema
 
 func NewListNestedObjectValueOfSliceMust[T any](ctx context.Context, ts []*T, f ...NestedObjectOfOption[T]) ListNestedObjectValueOf[T] {
 	opts := newNestedObjectOfOptions(f...)
+	ts = filterOmittedPtrElements(ts, opts)
+	if opts.OmitEmpty && len(ts) == 0 {
+		return NewListNestedObjectValueOfNull[T](ctx, WithSemanticEqualityFunc(opts.SemanticEqualityFunc))
+	}
+
 	return fwdiag.Must(NewListNestedObjectValueOfSlice(ctx, ts, opts.SemanticEqualityFunc))
 }
 
 func NewListNestedObjectValueOfValueSlice[T any](ctx context.Context, ts []T, f ...NestedObjectOfOption[T]) (ListNestedObjectValueOf[T], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	opts := newNestedObjectOfOptions(f...)
+	ts = filterOmittedValueElements(ts, opts)
+	if opts.OmitEmpty && len(ts) == 0 {
+		return NewListNestedObjectValueOfNull[T](ctx, WithSemanticEqualityFunc(opts.SemanticEqualityFunc)), diags
+	}
+
 	return newListNestedObjectValueOf[T](ctx, ts, opts.SemanticEqualityFunc)
 }
 
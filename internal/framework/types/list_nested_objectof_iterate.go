@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Len returns the number of elements in the list without decoding any of them.
+func (v ListNestedObjectValueOf[T]) Len() int {
+	return len(v.ListValue.Elements())
+}
+
+// Iterate calls f once per element of the list, in order, decoding each element just before the
+// call rather than materializing the whole slice up front the way ToSlice does. It stops early,
+// without decoding the remaining elements, as soon as f returns stop == true or a diagnostic with
+// an error. Iterate is intended for large collections (S3 bucket policy statements, WAF rule
+// groups, Lake Formation permissions) where decoding every element to answer a question about one
+// of them dominates plan time.
+func (v ListNestedObjectValueOf[T]) Iterate(ctx context.Context, f func(i int, ptr *T) (stop bool, diags diag.Diagnostics)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elements := v.ListValue.Elements()
+	for i, element := range elements {
+		ptr, d := objectValueObjectPtr[T](ctx, element)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		stop, d := f(i, ptr)
+		diags.Append(d...)
+		if diags.HasError() || stop {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// IterateErr is Iterate for callers that want to report their own error rather than build
+// diag.Diagnostics, stopping iteration as soon as f returns a non-nil error.
+func (v ListNestedObjectValueOf[T]) IterateErr(ctx context.Context, f func(i int, ptr *T) (stop bool, err error)) (error, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var errResult error
+
+	diags.Append(v.Iterate(ctx, func(i int, ptr *T) (bool, diag.Diagnostics) {
+		var d diag.Diagnostics
+
+		stop, err := f(i, ptr)
+		if err != nil {
+			errResult = err
+			return true, d
+		}
+
+		return stop, d
+	})...)
+
+	return errResult, diags
+}
+
+// Range is an alias for Iterate, named to read naturally at call sites that want an
+// early-termination loop over the list's elements but prefer that vocabulary to "Iterate", e.g.
+// `v.Range(ctx, func(i int, ptr *T) (bool, diag.Diagnostics) { ... })`.
+func (v ListNestedObjectValueOf[T]) Range(ctx context.Context, f func(i int, ptr *T) (stop bool, diags diag.Diagnostics)) diag.Diagnostics {
+	return v.Iterate(ctx, f)
+}
+
+// Find returns a pointer to the first element for which pred returns true, without decoding the
+// elements after it. The second return value is false if no element satisfies pred.
+func (v ListNestedObjectValueOf[T]) Find(ctx context.Context, pred func(*T) bool) (*T, bool, diag.Diagnostics) {
+	var found *T
+	var ok bool
+
+	diags := v.Iterate(ctx, func(i int, ptr *T) (bool, diag.Diagnostics) {
+		var d diag.Diagnostics
+
+		if pred(ptr) {
+			found = ptr
+			ok = true
+			return true, d
+		}
+
+		return false, d
+	})
+
+	return found, ok, diags
+}
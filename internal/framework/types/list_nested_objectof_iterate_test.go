@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type iterateTestElement struct {
+	Name basetypes.StringValue `tfsdk:"name"`
+}
+
+func newIterateTestValue(t *testing.T) ListNestedObjectValueOf[iterateTestElement] {
+	t.Helper()
+
+	ctx := context.Background()
+	elements := []iterateTestElement{
+		{Name: basetypes.NewStringValue("a")},
+		{Name: basetypes.NewStringValue("b")},
+		{Name: basetypes.NewStringValue("c")},
+	}
+
+	value, diags := NewListNestedObjectValueOfValueSlice(ctx, elements)
+	if diags.HasError() {
+		t.Fatalf("unexpected error building test value: %s", diags)
+	}
+
+	return value
+}
+
+func TestListNestedObjectValueOfLen(t *testing.T) {
+	t.Parallel()
+
+	value := newIterateTestValue(t)
+
+	if got, want := value.Len(), 3; got != want {
+		t.Errorf("Len() = %d, want %d", got, want)
+	}
+}
+
+func TestListNestedObjectValueOfIterateStopsEarly(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	value := newIterateTestValue(t)
+
+	var visited []string
+	diags := value.Iterate(ctx, func(i int, ptr *iterateTestElement) (bool, diag.Diagnostics) {
+		visited = append(visited, ptr.Name.ValueString())
+		return ptr.Name.ValueString() == "b", nil
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if got, want := visited, []string{"a", "b"}; !stringSlicesEqual(got, want) {
+		t.Errorf("visited = %v, want %v", got, want)
+	}
+}
+
+func TestListNestedObjectValueOfIterateErrStopsEarly(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	value := newIterateTestValue(t)
+
+	sentinel := errors.New("stop")
+
+	var visited []string
+	err, diags := value.IterateErr(ctx, func(i int, ptr *iterateTestElement) (bool, error) {
+		visited = append(visited, ptr.Name.ValueString())
+		if ptr.Name.ValueString() == "b" {
+			return true, sentinel
+		}
+		return false, nil
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if !errors.Is(err, sentinel) {
+		t.Errorf("got error %v, want %v", err, sentinel)
+	}
+
+	if got, want := visited, []string{"a", "b"}; !stringSlicesEqual(got, want) {
+		t.Errorf("visited = %v, want %v", got, want)
+	}
+}
+
+func TestListNestedObjectValueOfFind(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	value := newIterateTestValue(t)
+
+	found, ok, diags := value.Find(ctx, func(e *iterateTestElement) bool {
+		return e.Name.ValueString() == "b"
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+	if !ok {
+		t.Fatal("got ok = false, want true")
+	}
+	if got, want := found.Name.ValueString(), "b"; got != want {
+		t.Errorf("found.Name = %q, want %q", got, want)
+	}
+
+	_, ok, diags = value.Find(ctx, func(e *iterateTestElement) bool {
+		return e.Name.ValueString() == "does-not-exist"
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+	if ok {
+		t.Error("got ok = true, want false")
+	}
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+// filterOmittedPtrElements returns ts with every element matching opts.OmitEmptyElementsFunc
+// removed. It returns ts unchanged if no such function is configured.
+func filterOmittedPtrElements[T any](ts []*T, opts nestedObjectOfOptions[T]) []*T {
+	if opts.OmitEmptyElementsFunc == nil {
+		return ts
+	}
+
+	filtered := make([]*T, 0, len(ts))
+	for _, t := range ts {
+		if !opts.OmitEmptyElementsFunc(t) {
+			filtered = append(filtered, t)
+		}
+	}
+
+	return filtered
+}
+
+// filterOmittedValueElements is filterOmittedPtrElements for a slice of values rather than
+// pointers.
+func filterOmittedValueElements[T any](ts []T, opts nestedObjectOfOptions[T]) []T {
+	if opts.OmitEmptyElementsFunc == nil {
+		return ts
+	}
+
+	filtered := make([]T, 0, len(ts))
+	for i := range ts {
+		if !opts.OmitEmptyElementsFunc(&ts[i]) {
+			filtered = append(filtered, ts[i])
+		}
+	}
+
+	return filtered
+}
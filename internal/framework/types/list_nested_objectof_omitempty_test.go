@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type omitEmptyTestElement struct {
+	Name basetypes.StringValue `tfsdk:"name"`
+}
+
+func TestNewListNestedObjectValueOfPtrWithOmitEmpty(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("nil pointer collapses to null", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := NewListNestedObjectValueOfPtr[omitEmptyTestElement](ctx, nil, WithOmitEmpty[omitEmptyTestElement]())
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags)
+		}
+		if !got.IsNull() {
+			t.Error("got a non-null value, want null")
+		}
+	})
+
+	t.Run("zero-valued pointer collapses to null", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := NewListNestedObjectValueOfPtr(ctx, &omitEmptyTestElement{}, WithOmitEmpty[omitEmptyTestElement]())
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags)
+		}
+		if !got.IsNull() {
+			t.Error("got a non-null value, want null")
+		}
+	})
+
+	t.Run("non-empty pointer is preserved", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := NewListNestedObjectValueOfPtr(ctx, &omitEmptyTestElement{Name: basetypes.NewStringValue("x")}, WithOmitEmpty[omitEmptyTestElement]())
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags)
+		}
+		if got.IsNull() {
+			t.Error("got a null value, want non-null")
+		}
+		if got, want := got.Len(), 1; got != want {
+			t.Errorf("Len() = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("without WithOmitEmpty a zero-valued pointer is preserved", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := NewListNestedObjectValueOfPtr(ctx, &omitEmptyTestElement{})
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags)
+		}
+		if got.IsNull() {
+			t.Error("got a null value, want non-null")
+		}
+	})
+}
+
+func TestNewListNestedObjectValueOfValueSliceMustWithOmitEmpty(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("all-empty slice collapses to null", func(t *testing.T) {
+		t.Parallel()
+
+		got := NewListNestedObjectValueOfValueSliceMust(ctx, []omitEmptyTestElement{{}, {}}, WithOmitEmpty[omitEmptyTestElement]())
+		if !got.IsNull() {
+			t.Error("got a non-null value, want null")
+		}
+	})
+
+	t.Run("mixed slice keeps only non-empty elements", func(t *testing.T) {
+		t.Parallel()
+
+		got := NewListNestedObjectValueOfValueSliceMust(ctx, []omitEmptyTestElement{
+			{},
+			{Name: basetypes.NewStringValue("x")},
+		}, WithOmitEmpty[omitEmptyTestElement]())
+
+		if got.IsNull() {
+			t.Fatal("got a null value, want non-null")
+		}
+		if got, want := got.Len(), 1; got != want {
+			t.Errorf("Len() = %d, want %d", got, want)
+		}
+	})
+}
+
+func TestWithOmitEmptyElementsCustomPredicate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pred := func(e *omitEmptyTestElement) bool {
+		return e.Name.ValueString() == "drop-me"
+	}
+
+	got := NewListNestedObjectValueOfValueSliceMust(ctx, []omitEmptyTestElement{
+		{Name: basetypes.NewStringValue("keep-me")},
+		{Name: basetypes.NewStringValue("drop-me")},
+	}, WithOmitEmptyElements(pred))
+
+	elements, diags := got.ToSlice(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if len(elements) != 1 {
+		t.Fatalf("got %d elements, want 1", len(elements))
+	}
+	if got, want := elements[0].Name.ValueString(), "keep-me"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestFilterOmittedPtrElements(t *testing.T) {
+	t.Parallel()
+
+	ts := []*omitEmptyTestElement{
+		{Name: basetypes.NewStringValue("keep-me")},
+		{Name: basetypes.NewStringValue("drop-me")},
+	}
+
+	opts := nestedObjectOfOptions[omitEmptyTestElement]{
+		OmitEmptyElementsFunc: func(e *omitEmptyTestElement) bool {
+			return e.Name.ValueString() == "drop-me"
+		},
+	}
+
+	got := filterOmittedPtrElements(ts, opts)
+	if len(got) != 1 {
+		t.Fatalf("got %d elements, want 1", len(got))
+	}
+	if got, want := got[0].Name.ValueString(), "keep-me"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestFilterOmittedPtrElementsNoPredicate(t *testing.T) {
+	t.Parallel()
+
+	ts := []*omitEmptyTestElement{{Name: basetypes.NewStringValue("x")}}
+
+	got := filterOmittedPtrElements(ts, nestedObjectOfOptions[omitEmptyTestElement]{})
+	if len(got) != len(ts) {
+		t.Fatalf("got %d elements, want %d", len(got), len(ts))
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// wrapperTestElement, wrapperType, and wrapperValue exercise the embedding hooks that
+// ListNestedObjectTypable[T]/ListNestedObjectValuable[T] exist for: a provider-defined type
+// embedding ListNestedObjectTypeOf[T] and overriding ValueFromListNestedObject so that it, and its
+// corresponding value type, round-trip as themselves rather than as the bare
+// ListNestedObjectValueOf[T].
+type wrapperTestElement struct {
+	Name basetypes.StringValue `tfsdk:"name"`
+}
+
+type wrapperType struct {
+	ListNestedObjectTypeOf[wrapperTestElement]
+}
+
+var (
+	_ ListNestedObjectTypable[wrapperTestElement]  = (*wrapperType)(nil)
+	_ ListNestedObjectValuable[wrapperTestElement] = (*wrapperValue)(nil)
+)
+
+func (t wrapperType) ValueFromListNestedObject(ctx context.Context, v ListNestedObjectValueOf[wrapperTestElement]) (ListNestedObjectValuable[wrapperTestElement], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return wrapperValue{ListNestedObjectValueOf: v}, diags
+}
+
+// ValueFromList, ValueFromTerraform, and ValueType must be overridden too, each delegating to the
+// matching ListNestedObjectTypableValueFrom*/ValueType helper and passing t (not the embedded
+// ListNestedObjectTypeOf[wrapperTestElement]) as typ -- see the doc comment on
+// ValueFromListNestedObject for why the promoted versions of these methods aren't enough.
+
+func (t wrapperType) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return ListNestedObjectTypableValueFromList[wrapperTestElement](ctx, t, t.SemanticEqualityFunc(), in)
+}
+
+func (t wrapperType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return ListNestedObjectTypableValueFromTerraform[wrapperTestElement](ctx, t, in)
+}
+
+func (t wrapperType) ValueType(ctx context.Context) attr.Value {
+	return ListNestedObjectTypableValueType[wrapperTestElement](ctx, t, t.SemanticEqualityFunc())
+}
+
+type wrapperValue struct {
+	ListNestedObjectValueOf[wrapperTestElement]
+}
+
+func (v wrapperValue) ToListNestedObject(ctx context.Context) (ListNestedObjectValueOf[wrapperTestElement], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return v.ListNestedObjectValueOf, diags
+}
+
+// Type must be overridden too, for the same reason ValueFromList/ValueFromTerraform/ValueType are
+// on wrapperType: the promoted version always reports the bare
+// ListNestedObjectTypeOf[wrapperTestElement], never wrapperType.
+func (v wrapperValue) Type(ctx context.Context) attr.Type {
+	return wrapperType{ListNestedObjectTypeOf: NewListNestedObjectTypeOf[wrapperTestElement](ctx, WithSemanticEqualityFunc(v.SemanticEqualityFunc()))}
+}
+
+func TestListNestedObjectTypeOfEmbedding(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	elemType, diags := newObjectTypeOf[wrapperTestElement](ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error building element type: %s", diags)
+	}
+
+	objectValue, diags := basetypes.NewObjectValue(elemType.AttrTypes, map[string]attr.Value{"name": basetypes.NewStringValue("x")})
+	if diags.HasError() {
+		t.Fatalf("unexpected error building object value: %s", diags)
+	}
+
+	listValue, diags := basetypes.NewListValue(elemType, []attr.Value{objectValue})
+	if diags.HasError() {
+		t.Fatalf("unexpected error building list value: %s", diags)
+	}
+
+	wt := wrapperType{ListNestedObjectTypeOf: NewListNestedObjectTypeOf[wrapperTestElement](ctx)}
+
+	got, diags := wt.ValueFromList(ctx, listValue)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	wv, ok := got.(wrapperValue)
+	if !ok {
+		t.Fatalf("got %T, want wrapperValue", got)
+	}
+
+	base, diags := wv.ToListNestedObject(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	elements, diags := base.ToSlice(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if len(elements) != 1 {
+		t.Fatalf("got %d elements, want 1", len(elements))
+	}
+
+	if got, want := elements[0].Name.ValueString(), "x"; got != want {
+		t.Errorf("got Name %q, want %q", got, want)
+	}
+}
+
+func TestListNestedObjectTypeOfEmbeddingValueFromTerraformAndValueType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wt := wrapperType{ListNestedObjectTypeOf: NewListNestedObjectTypeOf[wrapperTestElement](ctx)}
+
+	objectValue := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "x"),
+	})
+	listValue := tftypes.NewValue(tftypes.List{ElementType: objectValue.Type()}, []tftypes.Value{objectValue})
+
+	got, err := wt.ValueFromTerraform(ctx, listValue)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := got.(wrapperValue); !ok {
+		t.Fatalf("ValueFromTerraform() returned %T, want wrapperValue", got)
+	}
+
+	if _, ok := wt.ValueType(ctx).(wrapperValue); !ok {
+		t.Fatalf("ValueType() returned %T, want wrapperValue", wt.ValueType(ctx))
+	}
+
+	wv, ok := got.(wrapperValue)
+	if !ok {
+		t.Fatalf("got %T, want wrapperValue", got)
+	}
+
+	if _, ok := wv.Type(ctx).(wrapperType); !ok {
+		t.Fatalf("Type() returned %T, want wrapperType", wv.Type(ctx))
+	}
+}
+
+func TestListNestedObjectValueOfRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	value, diags := NewListNestedObjectValueOfValueSlice(ctx, []wrapperTestElement{{Name: basetypes.NewStringValue("x")}})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	got, diags := value.ToListNestedObject(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if !got.Equal(value) {
+		t.Errorf("ToListNestedObject() = %v, want %v", got, value)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// NestedObjectCollectionType is implemented by the attribute type of a nested object collection
+// (ListNestedObjectTypeOf[T] today; its eventual Set/Map companions later), giving shared flex
+// code a single interface to construct and populate elements through without type-switching on
+// every concrete collection type.
+type NestedObjectCollectionType interface {
+	attr.Type
+
+	// ElementType is redeclared here (it's also promotable from basetypes.ListType) so that code
+	// holding only a NestedObjectCollectionType/ListNestedObjectTypable[T] interface value -- e.g.
+	// ListNestedObjectTypeOf[T].Equal comparing itself against another ListNestedObjectTypable[T]
+	// -- can call it without a further type assertion to a concrete basetypes type.
+	ElementType() attr.Type
+	NewObjectPtr(ctx context.Context) (any, diag.Diagnostics)
+	NewObjectSlice(ctx context.Context, len, cap int) (any, diag.Diagnostics)
+	ValueFromObjectPtr(ctx context.Context, ptr any) (attr.Value, diag.Diagnostics)
+	ValueFromObjectSlice(ctx context.Context, slice any) (attr.Value, diag.Diagnostics)
+}
+
+// NestedObjectCollectionValue is implemented by the value of a nested object collection.
+type NestedObjectCollectionValue interface {
+	attr.Value
+
+	ToObjectPtr(ctx context.Context) (any, diag.Diagnostics)
+	ToObjectSlice(ctx context.Context) (any, diag.Diagnostics)
+}
+
+// NestedCollectionValue is the narrower interface semanticEqualityFunc[T] operates over: just
+// enough to decode a nested object collection's elements for comparison.
+type NestedCollectionValue[T any] interface {
+	attr.Value
+
+	ToSlice(ctx context.Context) ([]*T, diag.Diagnostics)
+}
+
+// valueWithElements is satisfied by any attr.Value that exposes its elements, e.g.
+// basetypes.ListValue.
+type valueWithElements interface {
+	Elements() []attr.Value
+}
+
+// objectTypeOf is the attr.Type of the elements of a nested object collection: a Terraform Object
+// whose attributes are derived by reflecting over T's exported, `tfsdk`-tagged fields.
+type objectTypeOf[T any] struct {
+	basetypes.ObjectType
+}
+
+// NewObjectTypeOf returns the objectTypeOf[T] for T, panicking if T can't be reflected into a
+// valid Object attribute type. Most callers go through this rather than newObjectTypeOf because,
+// like the rest of this package's New* constructors, T is expected to be a well-formed,
+// compile-time-known model struct.
+func NewObjectTypeOf[T any](ctx context.Context) objectTypeOf[T] {
+	return fwdiag.Must(newObjectTypeOf[T](ctx))
+}
+
+func newObjectTypeOf[T any](ctx context.Context) (objectTypeOf[T], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrTypes, d := objectAttributeTypesOf[T](ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return objectTypeOf[T]{}, diags
+	}
+
+	return objectTypeOf[T]{ObjectType: basetypes.ObjectType{AttrTypes: attrTypes}}, diags
+}
+
+// objectAttributeTypesOf reflects over T's exported fields to build the attr.Type map for the
+// Terraform Object that backs T, the same map ValueFromObjectPtr/ValueFromObjectSlice use (via
+// newObjectTypeOf) when flattening a Go value into a ListNestedObjectValueOf[T] and vice versa.
+//
+// A field's `tfsdk` tag gives the attribute name. If the field also carries an `fwtype` tag (e.g.
+// `tfsdk:"role_arn" fwtype:"arn"`), the attr.Type registered for that tag in
+// fwtypeElementTypesByTag is used instead of the type the field's Go type would otherwise imply --
+// this is what lets a struct declare
+//
+//	RoleARN types.ARN `tfsdk:"role_arn" fwtype:"arn"`
+//
+// and get ARN validation/semantic-equality for free. Fields with no `fwtype` tag must themselves
+// implement attr.Value (basetypes.StringValue, types.ARN, a nested ListNestedObjectValueOf, ...); their own
+// Type(ctx) is used unchanged.
+func objectAttributeTypesOf[T any](ctx context.Context) (map[string]attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var zero T
+	rt := reflect.TypeOf(zero)
+	attrTypes := make(map[string]attr.Type, rt.NumField())
+
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+
+		name := field.Tag.Get("tfsdk")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		if fwtype := field.Tag.Get(fwtypeStructTag); fwtype != "" {
+			attrType, ok := fwtypeElementTypesByTag[fwtype]
+			if !ok {
+				diags.AddError("Invalid fwtype tag", fmt.Sprintf("%s: unknown fwtype %q", name, fwtype))
+				return nil, diags
+			}
+
+			attrTypes[name] = attrType
+			continue
+		}
+
+		valuable, ok := reflect.New(field.Type).Elem().Interface().(attr.Value)
+		if !ok {
+			diags.AddError("Unsupported field type", fmt.Sprintf("%s: %s does not implement attr.Value", name, field.Type))
+			return nil, diags
+		}
+
+		attrTypes[name] = valuable.Type(ctx)
+	}
+
+	return attrTypes, diags
+}
+
+func objectTypeNewObjectPtr[T any](_ context.Context) (any, diag.Diagnostics) { //nolint:unparam
+	var diags diag.Diagnostics
+
+	return new(T), diags
+}
+
+// objectValueObjectPtr decodes a single Terraform Object attr.Value into a *T, matching attributes
+// to fields by their `tfsdk` tag.
+func objectValueObjectPtr[T any](ctx context.Context, value attr.Value) (*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objectValue, ok := value.(basetypes.ObjectValue)
+	if !ok {
+		diags.AddError("Invalid object value", fmt.Sprintf("incorrect type: want %T, got %T", basetypes.ObjectValue{}, value))
+		return nil, diags
+	}
+
+	ptr := new(T)
+	diags.Append(objectValue.As(ctx, ptr, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ptr, diags
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"testing"
+)
+
+type fwtypeTestElement struct {
+	RoleARN ARN `tfsdk:"role_arn" fwtype:"arn"`
+}
+
+func TestObjectAttributeTypesOfFwtypeTag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	attrTypes, diags := objectAttributeTypesOf[fwtypeTestElement](ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	got, ok := attrTypes["role_arn"]
+	if !ok {
+		t.Fatal(`attrTypes["role_arn"] missing`)
+	}
+
+	if !got.Equal(ARNType) {
+		t.Errorf("attrTypes[%q] = %v, want %v", "role_arn", got, ARNType)
+	}
+}
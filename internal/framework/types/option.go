@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// NestedObjectOfOption is a functional option for configuring the construction of a
+// ListNestedObjectTypeOf[T] / ListNestedObjectValueOf[T] (and, by extension, their eventual set-
+// and map-nested-object companions).
+type NestedObjectOfOption[T any] func(*nestedObjectOfOptions[T])
+
+type nestedObjectOfOptions[T any] struct {
+	SemanticEqualityFunc        semanticEqualityFunc[T]
+	ElementSemanticEqualityFunc elementSemanticEqualityFunc[T]
+	ElementSemanticEqualityPath path.Path
+	KeyFunc                     func(*T) string
+	OmitEmpty                   bool
+	OmitEmptyElementsFunc       func(*T) bool
+}
+
+func newNestedObjectOfOptions[T any](optFns ...NestedObjectOfOption[T]) nestedObjectOfOptions[T] {
+	var opts nestedObjectOfOptions[T]
+
+	for _, optFn := range optFns {
+		optFn(&opts)
+	}
+
+	// WithElementSemanticEqualityFunc and WithSemanticEqualityFunc share the same underlying
+	// semanticEqualityFunc[T] slot. If only the former was configured, adapt it into the latter
+	// here so callers only ever have to read opts.SemanticEqualityFunc, regardless of option order.
+	if opts.SemanticEqualityFunc == nil && opts.ElementSemanticEqualityFunc != nil {
+		opts.SemanticEqualityFunc = adaptElementSemanticEqualityFunc(opts.ElementSemanticEqualityFunc, opts.KeyFunc, opts.ElementSemanticEqualityPath)
+	}
+
+	return opts
+}
+
+// WithSemanticEqualityFunc sets the function used to compare two ListNestedObjectValueOf[T]
+// instances for plan-diff purposes, overriding the plugin framework's default element-by-element
+// ListValue comparison.
+func WithSemanticEqualityFunc[T any](f semanticEqualityFunc[T]) NestedObjectOfOption[T] {
+	return func(opts *nestedObjectOfOptions[T]) {
+		opts.SemanticEqualityFunc = f
+	}
+}
+
+// WithOmitEmpty causes a nested object collection built from a Go value (via
+// ValueFromObjectPtr/ValueFromObjectSlice or the NewListNestedObjectValueOf* constructors) to
+// collapse to a null list instead of an empty (`[]`) one once the elements matching the
+// WithOmitEmptyElements predicate have been removed, or, for a pointer-backed collection, when the
+// pointer is nil or its single element matches that predicate. With no predicate configured,
+// WithOmitEmpty installs the default one (every field zero), so zero-valued elements of a slice are
+// removed as well. This eliminates the common class of perpetual-diff
+// bugs where AWS omits an optional nested block that Terraform would otherwise insist on sending
+// back as `[]`.
+func WithOmitEmpty[T any]() NestedObjectOfOption[T] {
+	return func(opts *nestedObjectOfOptions[T]) {
+		opts.OmitEmpty = true
+		if opts.OmitEmptyElementsFunc == nil {
+			opts.OmitEmptyElementsFunc = isZeroElement[T]
+		}
+	}
+}
+
+// WithOmitEmptyElements filters elements matching pred out of a nested object collection built
+// from a Go slice (via ValueFromObjectSlice or the slice constructors that take options), before
+// it is written back to state. A pointer-backed collection consults pred only when WithOmitEmpty
+// is also configured, and then collapses to null; otherwise its single element is kept whether or
+// not it matches pred. If pred is nil, an element is considered
+// empty when every one of its fields is the zero value (which, for `tfsdk`-tagged fields backed by
+// basetypes values, coincides with the field being null).
+func WithOmitEmptyElements[T any](pred func(*T) bool) NestedObjectOfOption[T] {
+	return func(opts *nestedObjectOfOptions[T]) {
+		if pred == nil {
+			pred = isZeroElement[T]
+		}
+		opts.OmitEmptyElementsFunc = pred
+	}
+}
+
+// WithElementSemanticEqualityFunc registers a per-element comparator, invoked once per paired
+// element instead of once for the whole collection. Diagnostics it returns are automatically
+// annotated with attributeName (the `tfsdk` name of the list attribute itself) and the index of the
+// element that produced them, e.g. path.Root("rule").AtListIndex(2), so users can tell which rule
+// actually differed. Pairing is positional unless WithKeyFunc is also configured, in which case
+// elements are paired by key instead so that reordering alone doesn't produce a diff.
+func WithElementSemanticEqualityFunc[T any](attributeName string, f elementSemanticEqualityFunc[T]) NestedObjectOfOption[T] {
+	return func(opts *nestedObjectOfOptions[T]) {
+		opts.ElementSemanticEqualityFunc = f
+		opts.ElementSemanticEqualityPath = path.Root(attributeName)
+	}
+}
+
+// WithKeyFunc configures the key used to pair elements between the old and new collection when
+// WithElementSemanticEqualityFunc is also configured, instead of pairing them positionally.
+func WithKeyFunc[T any](keyFn func(*T) string) NestedObjectOfOption[T] {
+	return func(opts *nestedObjectOfOptions[T]) {
+		opts.KeyFunc = keyFn
+	}
+}
+
+// isZeroElement reports whether ptr is nil or points to a zero-valued T, the default notion of
+// "empty" used by WithOmitEmpty/WithOmitEmptyElements when no predicate is supplied.
+func isZeroElement[T any](ptr *T) bool {
+	if ptr == nil {
+		return true
+	}
+
+	return reflect.ValueOf(*ptr).IsZero()
+}
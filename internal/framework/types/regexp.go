@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable  = (*regexpType)(nil)
+	_ basetypes.StringValuable = (*Regexp)(nil)
+)
+
+// regexpType is the attribute type of a Regexp value. Fields declared as `types.Regexp` in a
+// struct consumed by ListNestedObjectValueOf[T] (tagged `tfsdk:"pattern" fwtype:"regexp"`) get
+// this type instead of the plain basetypes.StringType, which means they validate as well-formed
+// RE2 regular expressions and can be compiled directly.
+type regexpType struct {
+	basetypes.StringType
+}
+
+// RegexpType is the attr.Type for a regular-expression-valued attribute.
+var RegexpType = regexpType{}
+
+func (t regexpType) Equal(o attr.Type) bool {
+	other, ok := o.(regexpType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t regexpType) String() string {
+	return "RegexpType"
+}
+
+func (t regexpType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() || in.IsUnknown() {
+		return Regexp{StringValue: in}, diags
+	}
+
+	if _, err := regexp.Compile(in.ValueString()); err != nil {
+		diags.AddError("Invalid regular expression", fmt.Sprintf("%q: %s", in.ValueString(), err))
+		return nil, diags
+	}
+
+	return Regexp{StringValue: in}, diags
+}
+
+func (t regexpType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t regexpType) ValueType(ctx context.Context) attr.Value {
+	return Regexp{}
+}
+
+// Regexp represents a Terraform Plugin Framework String value that holds a well-formed RE2
+// regular expression.
+type Regexp struct {
+	basetypes.StringValue
+}
+
+func (v Regexp) Equal(o attr.Value) bool {
+	other, ok := o.(Regexp)
+
+	if !ok {
+		return false
+	}
+
+	if v.IsNull() || v.IsUnknown() || other.IsNull() || other.IsUnknown() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	// *regexp.Regexp isn't meaningfully comparable by == (two independently-compiled patterns are
+	// distinct pointers even when identical), so compare via String() once both sides are
+	// confirmed to compile. This still validates both values as well-formed regular expressions
+	// before comparing, unlike a bare StringValue.Equal.
+	vRegexp, diags := v.ValueRegexp()
+	if diags.HasError() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	oRegexp, diags := other.ValueRegexp()
+	if diags.HasError() {
+		return v.StringValue.Equal(other.StringValue)
+	}
+
+	return vRegexp.String() == oRegexp.String()
+}
+
+func (v Regexp) Type(ctx context.Context) attr.Type {
+	return RegexpType
+}
+
+// ValueRegexp returns the compiled *regexp.Regexp represented by the value, or nil and an error
+// diagnostic if the value isn't a syntactically valid regular expression.
+func (v Regexp) ValueRegexp() (*regexp.Regexp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	re, err := regexp.Compile(v.ValueString())
+	if err != nil {
+		diags.AddError("Invalid regular expression", fmt.Sprintf("%q: %s", v.ValueString(), err))
+		return nil, diags
+	}
+
+	return re, diags
+}
+
+// RegexpValue returns a Regexp holding s. It does not validate that s is a well-formed regular
+// expression; use ValueRegexp to do so.
+func RegexpValue(s string) Regexp {
+	return Regexp{StringValue: basetypes.NewStringValue(s)}
+}
+
+func RegexpNull() Regexp {
+	return Regexp{StringValue: basetypes.NewStringNull()}
+}
+
+func RegexpUnknown() Regexp {
+	return Regexp{StringValue: basetypes.NewStringUnknown()}
+}
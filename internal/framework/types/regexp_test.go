@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestRegexpTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		in      basetypes.StringValue
+		wantErr bool
+	}{
+		"valid regexp":   {in: basetypes.NewStringValue("^[a-z]+$")},
+		"invalid regexp": {in: basetypes.NewStringValue("(unclosed"), wantErr: true},
+		"null value":     {in: basetypes.NewStringNull()},
+		"unknown value":  {in: basetypes.NewStringUnknown()},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := RegexpType.ValueFromString(ctx, testCase.in)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("HasError() = %t, want %t (diags: %s)", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestRegexpValueRegexp(t *testing.T) {
+	t.Parallel()
+
+	if _, diags := RegexpValue("^[a-z]+$").ValueRegexp(); diags.HasError() {
+		t.Errorf("unexpected error: %s", diags)
+	}
+
+	if _, diags := RegexpValue("(unclosed").ValueRegexp(); !diags.HasError() {
+		t.Error("expected an error for an invalid regular expression")
+	}
+}
+
+func TestRegexpEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b Regexp
+		want bool
+	}{
+		"equal patterns": {
+			a:    RegexpValue("^[a-z]+$"),
+			b:    RegexpValue("^[a-z]+$"),
+			want: true,
+		},
+		"different patterns": {
+			a:    RegexpValue("^[a-z]+$"),
+			b:    RegexpValue("^[0-9]+$"),
+			want: false,
+		},
+		"null and unknown": {
+			a:    RegexpNull(),
+			b:    RegexpUnknown(),
+			want: false,
+		},
+		"both null": {
+			a:    RegexpNull(),
+			b:    RegexpNull(),
+			want: true,
+		},
+		"invalid pattern falls back to string equality": {
+			a:    RegexpValue("(unclosed"),
+			b:    RegexpValue("(unclosed"),
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.a.Equal(testCase.b); got != testCase.want {
+				t.Errorf("Equal() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NewUnorderedSemanticEqualityFunc returns a semanticEqualityFunc[T] that compares two nested
+// object collections as unordered multisets. Elements are grouped by the key returned by keyFn,
+// and two collections are considered equal only if, for every key, the same number of elements
+// share that key on both sides and each old element can be paired with a distinct new element
+// under the same key for which cmpFn reports equal. A key appearing a different number of times on
+// each side (e.g. a duplicated element disappearing) is treated as unequal, not just a differing
+// key set. This avoids spurious plan diffs for AWS APIs that return nested block collections
+// (security group rules, tag lists, IAM statements, etc) in a different order than they were
+// configured in.
+func NewUnorderedSemanticEqualityFunc[T any](keyFn func(*T) string, cmpFn func(a, b *T) bool) semanticEqualityFunc[T] {
+	return func(ctx context.Context, oldValue, newValue NestedCollectionValue[T]) (bool, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		oldSlice, d := oldValue.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		newSlice, d := newValue.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if len(oldSlice) != len(newSlice) {
+			return false, diags
+		}
+
+		oldByKey := make(map[string][]*T, len(oldSlice))
+		for _, v := range oldSlice {
+			k := keyFn(v)
+			oldByKey[k] = append(oldByKey[k], v)
+		}
+
+		newByKey := make(map[string][]*T, len(newSlice))
+		for _, v := range newSlice {
+			k := keyFn(v)
+			newByKey[k] = append(newByKey[k], v)
+		}
+
+		if len(oldByKey) != len(newByKey) {
+			return false, diags
+		}
+
+		for k, ovs := range oldByKey {
+			nvs, ok := newByKey[k]
+			if !ok || len(ovs) != len(nvs) {
+				return false, diags
+			}
+
+			// Match each old element under this key against an unused new element under the same
+			// key, so that e.g. two old elements sharing a key must pair with two distinct new
+			// elements rather than both matching the same one.
+			unmatched := make([]*T, len(nvs))
+			copy(unmatched, nvs)
+			for _, ov := range ovs {
+				matched := false
+				for i, nv := range unmatched {
+					if nv == nil {
+						continue
+					}
+					if cmpFn(ov, nv) {
+						unmatched[i] = nil
+						matched = true
+						break
+					}
+				}
+				if !matched {
+					return false, diags
+				}
+			}
+		}
+
+		return true, diags
+	}
+}
+
+// OrderedByField returns a semanticEqualityFunc[T] that key-matches elements of type T by the
+// value of the struct field tagged `tfsdk:"fieldName"`, falling back to reflect.DeepEqual to
+// compare paired elements. It's a convenience wrapper around NewUnorderedSemanticEqualityFunc for
+// the common case of a single identifying attribute (e.g. an ARN, name, or ID field).
+func OrderedByField[T any](fieldName string) semanticEqualityFunc[T] {
+	return NewUnorderedSemanticEqualityFunc(fieldKeyFunc[T](fieldName), func(a, b *T) bool {
+		return reflect.DeepEqual(a, b)
+	})
+}
+
+// fieldKeyFunc returns a function that extracts the string representation of the named `tfsdk`
+// struct field from a *T, for use as the key in NewUnorderedSemanticEqualityFunc.
+func fieldKeyFunc[T any](fieldName string) func(*T) string {
+	return func(v *T) string {
+		if v == nil {
+			return ""
+		}
+
+		rv := reflect.ValueOf(v).Elem()
+		rt := rv.Type()
+		for i := range rt.NumField() {
+			if tag := rt.Field(i).Tag.Get("tfsdk"); tag == fieldName {
+				return fmt.Sprintf("%v", rv.Field(i).Interface())
+			}
+		}
+
+		return ""
+	}
+}
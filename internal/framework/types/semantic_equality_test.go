@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type semanticEqualityTestElement struct {
+	ID    basetypes.StringValue `tfsdk:"id"`
+	Value basetypes.StringValue `tfsdk:"value"`
+}
+
+func semanticEqualityTestElementKey(v *semanticEqualityTestElement) string {
+	return v.ID.ValueString()
+}
+
+func semanticEqualityTestElementEqual(a, b *semanticEqualityTestElement) bool {
+	return a.Value.Equal(b.Value)
+}
+
+func TestNewUnorderedSemanticEqualityFunc(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	eq := NewUnorderedSemanticEqualityFunc(semanticEqualityTestElementKey, semanticEqualityTestElementEqual)
+
+	testCases := map[string]struct {
+		old, new []semanticEqualityTestElement
+		want     bool
+	}{
+		"identical": {
+			old:  []semanticEqualityTestElement{{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")}},
+			new:  []semanticEqualityTestElement{{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")}},
+			want: true,
+		},
+		"reordered": {
+			old: []semanticEqualityTestElement{
+				{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+				{ID: basetypes.NewStringValue("b"), Value: basetypes.NewStringValue("2")},
+			},
+			new: []semanticEqualityTestElement{
+				{ID: basetypes.NewStringValue("b"), Value: basetypes.NewStringValue("2")},
+				{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+			},
+			want: true,
+		},
+		"changed value": {
+			old:  []semanticEqualityTestElement{{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")}},
+			new:  []semanticEqualityTestElement{{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("2")}},
+			want: false,
+		},
+		"missing key": {
+			old: []semanticEqualityTestElement{
+				{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+				{ID: basetypes.NewStringValue("b"), Value: basetypes.NewStringValue("2")},
+			},
+			new:  []semanticEqualityTestElement{{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")}},
+			want: false,
+		},
+		"duplicate key disappearing is not equal": {
+			old: []semanticEqualityTestElement{
+				{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+				{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+			},
+			new:  []semanticEqualityTestElement{{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")}},
+			want: false,
+		},
+		"duplicate key preserved": {
+			old: []semanticEqualityTestElement{
+				{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+				{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+			},
+			new: []semanticEqualityTestElement{
+				{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+				{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")},
+			},
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			oldValue, diags := NewListNestedObjectValueOfValueSlice(ctx, testCase.old)
+			if diags.HasError() {
+				t.Fatalf("unexpected error building old value: %s", diags)
+			}
+
+			newValue, diags := NewListNestedObjectValueOfValueSlice(ctx, testCase.new)
+			if diags.HasError() {
+				t.Fatalf("unexpected error building new value: %s", diags)
+			}
+
+			got, diags := eq(ctx, oldValue, newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %s", diags)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestOrderedByField(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	eq := OrderedByField[semanticEqualityTestElement]("id")
+
+	oldElems := []semanticEqualityTestElement{{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")}}
+	newElems := []semanticEqualityTestElement{{ID: basetypes.NewStringValue("a"), Value: basetypes.NewStringValue("1")}}
+
+	oldValue, diags := NewListNestedObjectValueOfValueSlice(ctx, oldElems)
+	if diags.HasError() {
+		t.Fatalf("unexpected error building old value: %s", diags)
+	}
+
+	newValue, diags := NewListNestedObjectValueOfValueSlice(ctx, newElems)
+	if diags.HasError() {
+		t.Fatalf("unexpected error building new value: %s", diags)
+	}
+
+	got, diags := eq(ctx, oldValue, newValue)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if !got {
+		t.Errorf("got %t, want true", got)
+	}
+}